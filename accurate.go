@@ -0,0 +1,148 @@
+package cheapruler
+
+import (
+	"errors"
+	"math"
+)
+
+// ErrNoConvergence is returned by VincentyInverse when the iterative formula
+// fails to converge, which happens for near-antipodal points.
+var ErrNoConvergence = errors.New("vincenty formula failed to converge")
+
+// Mean Earth radius in meters, as used by the haversine formula.
+const earthRadiusMeters = 6371000.0
+
+// WGS84 ellipsoid parameters, as used by the Vincenty inverse formula.
+const (
+	wgs84SemiMajorAxis    = 6378137.0
+	wgs84Flattening       = 1 / 298.257223563
+	vincentyMaxIterations = 20
+	vincentyConvergence   = 1e-12
+)
+
+// Haversine returns the great-circle distance between two [lon, lat] points
+// in the given units, using the haversine formula on a spherical Earth. It
+// is slower but more accurate than CheapRuler.Distance outside city-scale
+// distances. An unrecognised units string falls back to kilometers.
+func Haversine(a []float64, b []float64, units string) float64 {
+	m, ok := unitFactors[units]
+	if !ok {
+		m = unitFactors["kilometers"]
+	}
+
+	lat1 := a[1] * math.Pi / 180
+	lat2 := b[1] * math.Pi / 180
+	dLat := (b[1] - a[1]) * math.Pi / 180
+	dLon := (b[0] - a[0]) * math.Pi / 180
+
+	sinDLat := math.Sin(dLat / 2)
+	sinDLon := math.Sin(dLon / 2)
+	h := sinDLat*sinDLat + math.Cos(lat1)*math.Cos(lat2)*sinDLon*sinDLon
+
+	meters := 2 * earthRadiusMeters * math.Asin(math.Sqrt(h))
+	return meters / 1000 * m
+}
+
+// VincentyInverse returns the distance (in the given units) and the initial
+// and final bearings (in degrees) between two [lon, lat] points, using the
+// Vincenty inverse formula on the WGS84 ellipsoid. It returns
+// ErrNoConvergence for near-antipodal points where the iteration fails to
+// settle.
+func VincentyInverse(a []float64, b []float64, units string) (dist float64, initBearing float64, finalBearing float64, err error) {
+	m, ok := unitFactors[units]
+	if !ok {
+		m = unitFactors["kilometers"]
+	}
+
+	f := wgs84Flattening
+	ellipsoidB := (1 - f) * wgs84SemiMajorAxis
+
+	L := (b[0] - a[0]) * math.Pi / 180
+	U1 := math.Atan((1 - f) * math.Tan(a[1]*math.Pi/180))
+	U2 := math.Atan((1 - f) * math.Tan(b[1]*math.Pi/180))
+	sinU1, cosU1 := math.Sin(U1), math.Cos(U1)
+	sinU2, cosU2 := math.Sin(U2), math.Cos(U2)
+
+	lambda := L
+	var sinSigma, cosSigma, sigma, cosSqAlpha, cos2SigmaM float64
+
+	converged := false
+	for i := 0; i < vincentyMaxIterations; i++ {
+		sinLambda, cosLambda := math.Sin(lambda), math.Cos(lambda)
+
+		sinSigma = math.Sqrt(
+			math.Pow(cosU2*sinLambda, 2) +
+				math.Pow(cosU1*sinU2-sinU1*cosU2*cosLambda, 2),
+		)
+		if sinSigma == 0 {
+			return 0, 0, 0, nil
+		}
+
+		cosSigma = sinU1*sinU2 + cosU1*cosU2*cosLambda
+		sigma = math.Atan2(sinSigma, cosSigma)
+
+		sinAlpha := cosU1 * cosU2 * sinLambda / sinSigma
+		cosSqAlpha = 1 - sinAlpha*sinAlpha
+
+		cos2SigmaM = 0.0
+		if cosSqAlpha != 0 {
+			cos2SigmaM = cosSigma - 2*sinU1*sinU2/cosSqAlpha
+		}
+
+		C := f / 16 * cosSqAlpha * (4 + f*(4-3*cosSqAlpha))
+		lambdaPrev := lambda
+		lambda = L + (1-C)*f*sinAlpha*
+			(sigma+C*sinSigma*(cos2SigmaM+C*cosSigma*(-1+2*cos2SigmaM*cos2SigmaM)))
+
+		if math.Abs(lambda-lambdaPrev) < vincentyConvergence {
+			converged = true
+			break
+		}
+	}
+
+	if !converged {
+		return 0, 0, 0, ErrNoConvergence
+	}
+
+	uSq := cosSqAlpha * (wgs84SemiMajorAxis*wgs84SemiMajorAxis - ellipsoidB*ellipsoidB) / (ellipsoidB * ellipsoidB)
+	A := 1 + uSq/16384*(4096+uSq*(-768+uSq*(320-175*uSq)))
+	B := uSq / 1024 * (256 + uSq*(-128+uSq*(74-47*uSq)))
+	deltaSigma := B * sinSigma * (cos2SigmaM + B/4*(cosSigma*(-1+2*cos2SigmaM*cos2SigmaM)-
+		B/6*cos2SigmaM*(-3+4*sinSigma*sinSigma)*(-3+4*cos2SigmaM*cos2SigmaM)))
+
+	meters := ellipsoidB * A * (sigma - deltaSigma)
+
+	sinLambda, cosLambda := math.Sin(lambda), math.Cos(lambda)
+	alpha1 := math.Atan2(cosU2*sinLambda, cosU1*sinU2-sinU1*cosU2*cosLambda)
+	alpha2 := math.Atan2(cosU1*sinLambda, -sinU1*cosU2+cosU1*sinU2*cosLambda)
+
+	dist = meters / 1000 * m
+	initBearing = math.Mod(alpha1*180/math.Pi+360, 360)
+	finalBearing = math.Mod(alpha2*180/math.Pi+360, 360)
+
+	return dist, initBearing, finalBearing, nil
+}
+
+// DistanceAccurate returns the distance between two points, transparently
+// degrading from the fast flat-earth formula to a correct spherical/
+// ellipsoidal one as the points get further apart. cheap-ruler's error grows
+// quickly past city scale, so points within AccuracyRadius use Distance,
+// points up to 1000km use Haversine, and anything further uses
+// VincentyInverse (falling back to Haversine if it fails to converge).
+func (cr CheapRuler) DistanceAccurate(a []float64, b []float64) float64 {
+	d := cr.Distance(a, b)
+	if d <= cr.AccuracyRadius {
+		return d
+	}
+
+	m := cr.Factors[cr.units]
+	if d <= 1000*m {
+		return Haversine(a, b, cr.units)
+	}
+
+	dist, _, _, err := VincentyInverse(a, b, cr.units)
+	if err != nil {
+		return Haversine(a, b, cr.units)
+	}
+	return dist
+}
@@ -0,0 +1,64 @@
+package cheapruler
+
+import (
+	"math"
+	"testing"
+)
+
+func TestHaversine(t *testing.T) {
+	sf := []float64{-122.42, 37.78}
+	paris := []float64{2.35, 48.85}
+
+	got := Haversine(sf, paris, "kilometers")
+	want := 8953.0
+
+	if math.Abs(got-want) > 5 {
+		t.Errorf("Haversine() = %v, want approximately %v", got, want)
+	}
+}
+
+func TestVincentyInverse(t *testing.T) {
+	sf := []float64{-122.42, 37.78}
+	paris := []float64{2.35, 48.85}
+
+	dist, _, _, err := VincentyInverse(sf, paris, "kilometers")
+	if err != nil {
+		t.Fatalf("VincentyInverse() error = %v", err)
+	}
+
+	want := 8976.0
+	if math.Abs(dist-want) > 5 {
+		t.Errorf("VincentyInverse() dist = %v, want approximately %v", dist, want)
+	}
+}
+
+func TestVincentyInverseAntipodal(t *testing.T) {
+	a := []float64{0, 0}
+	b := []float64{179.999999, 0.000001}
+
+	_, _, _, err := VincentyInverse(a, b, "kilometers")
+	if err != ErrNoConvergence {
+		t.Errorf("VincentyInverse() error = %v, want %v", err, ErrNoConvergence)
+	}
+}
+
+func TestDistanceAccurate(t *testing.T) {
+	cr, err := NewCheapruler(37.78, "kilometers")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	near := []float64{-122.42, 37.78}
+	nearB := []float64{-122.43, 37.79}
+
+	if got, want := cr.DistanceAccurate(near, nearB), cr.Distance(near, nearB); got != want {
+		t.Errorf("DistanceAccurate() within AccuracyRadius = %v, want %v", got, want)
+	}
+
+	paris := []float64{2.35, 48.85}
+	got := cr.DistanceAccurate(near, paris)
+	want := 8976.0
+	if math.Abs(got-want) > 5 {
+		t.Errorf("DistanceAccurate() far = %v, want approximately %v", got, want)
+	}
+}
@@ -11,6 +11,12 @@ type CheapRuler struct {
 	Kx      float64
 	Ky      float64
 	Factors map[string]float64
+	units   string
+
+	// AccuracyRadius is the distance (in the ruler's units) within which
+	// DistanceAccurate trusts the flat-earth approximation. Defaults to
+	// ~100km, the rough bound within which cheap-ruler's error stays small.
+	AccuracyRadius float64
 }
 
 // The closest point on the line from the given point and
@@ -21,46 +27,50 @@ type PointOnLine struct {
 	T     float64
 }
 
+// Multipliers for converting between units.
+var unitFactors = map[string]float64{
+	"kilometers":    1,
+	"miles":         1000 / 1609.344,
+	"nauticalmiles": 1000 / 1852,
+	"meters":        1000,
+	"metres":        1000,
+	"yards":         1000 / 0.9144,
+	"feet":          1000 / 0.3048,
+	"inches":        1000 / 0.0254,
+}
+
 // Create a new cheap ruler instance
 func NewCheapruler(lat float64, units string) (CheapRuler, error) {
-
-	cr := CheapRuler{}
-
-	// Multipliers for converting between units.
-	factors := map[string]float64{
-		"kilometers":    1,
-		"miles":         1000 / 1609.344,
-		"nauticalmiles": 1000 / 1852,
-		"meters":        1000,
-		"metres":        1000,
-		"yards":         1000 / 0.9144,
-		"feet":          1000 / 0.3048,
-		"inches":        1000 / 0.0254,
+	u, err := ParseUnit(units)
+	if err != nil {
+		return CheapRuler{}, err
 	}
+	return NewCheapRulerUnit(lat, u), nil
+}
 
-	if m, ok := factors[units]; ok {
-
-		cos := math.Cos(lat * math.Pi / 180)
-		cos2 := 2*cos*cos - 1
-		cos3 := 2*cos*cos2 - cos
-		cos4 := 2*cos*cos3 - cos2
-		cos5 := 2*cos*cos4 - cos3
-
-		// multipliers for converting longitude and latitude degrees into distance
-		// (http://1.usa.gov/1Wb1bv7)
-		cr.Kx = m * (111.41513*cos - 0.09455*cos3 + 0.00012*cos5)
-		cr.Ky = m * (111.13209 - 0.56605*cos2 + 0.0012*cos4)
-		cr.Factors = factors
+// Create a new cheap ruler instance from a typed Unit. Since Unit is a closed
+// enum this cannot fail, unlike NewCheapruler.
+func NewCheapRulerUnit(lat float64, u Unit) CheapRuler {
+	cr := CheapRuler{}
 
-		return cr, nil
+	units := u.String()
+	m := unitFactors[units]
 
-	} else {
+	cos := math.Cos(lat * math.Pi / 180)
+	cos2 := 2*cos*cos - 1
+	cos3 := 2*cos*cos2 - cos
+	cos4 := 2*cos*cos3 - cos2
+	cos5 := 2*cos*cos4 - cos3
 
-		err := errors.New(units + "is not a valid unit")
-		return cr, err
-
-	}
+	// multipliers for converting longitude and latitude degrees into distance
+	// (http://1.usa.gov/1Wb1bv7)
+	cr.Kx = m * (111.41513*cos - 0.09455*cos3 + 0.00012*cos5)
+	cr.Ky = m * (111.13209 - 0.56605*cos2 + 0.0012*cos4)
+	cr.Factors = unitFactors
+	cr.units = units
+	cr.AccuracyRadius = 100 * m
 
+	return cr
 }
 
 // Creates a CheapRuler struct from tile coordinates (y and z). Convenient in tile-reduce scripts.
@@ -72,9 +82,48 @@ func NewCheaprulerFromTile(y float64, z float64, units string) (CheapRuler, erro
 
 // Given two points returns the distance in the units of the ruler
 func (cr CheapRuler) Distance(a []float64, b []float64) float64 {
+	return math.Sqrt(cr.SquareDistance(a, b))
+}
+
+// Given two points returns the square of the distance in the units of the
+// ruler. Useful when only comparing distances against each other, since it
+// avoids the cost of the square root in Distance.
+func (cr CheapRuler) SquareDistance(a []float64, b []float64) float64 {
 	dx := (a[0] - b[0]) * cr.Kx
 	dy := (a[1] - b[1]) * cr.Ky
-	return math.Sqrt(dx*dx + dy*dy)
+	return dx*dx + dy*dy
+}
+
+// Returns the units the ruler was constructed with.
+func (cr CheapRuler) Unit() string {
+	return cr.units
+}
+
+// Recomputes Kx/Ky for the given units in place, by dividing out the old
+// unit factor and multiplying in the new one. This avoids redoing the
+// trigonometry in NewCheapruler, since Kx/Ky for any unit are just scalar
+// multiples of one another.
+func (cr *CheapRuler) ChangeUnit(units string) error {
+	m, ok := cr.Factors[units]
+	if !ok {
+		return errors.New(units + " is not a valid unit")
+	}
+
+	oldM := cr.Factors[cr.units]
+	cr.Kx = cr.Kx / oldM * m
+	cr.Ky = cr.Ky / oldM * m
+	cr.AccuracyRadius = cr.AccuracyRadius / oldM * m
+	cr.units = units
+
+	return nil
+}
+
+// Returns a copy of the ruler converted to the given units, leaving the
+// receiver untouched.
+func (cr CheapRuler) CloneWithUnit(units string) (CheapRuler, error) {
+	clone := cr
+	err := clone.ChangeUnit(units)
+	return clone, err
 }
 
 // Returns the bearing between two points in angles.
@@ -120,17 +169,14 @@ func (cr CheapRuler) Area(polygon [][][]float64) float64 {
 	for i := 0; i < len(polygon); i++ {
 		ring := polygon[i]
 		ringlen := len(ring)
-		k := ringlen - 1.0
 
-		for j := 0; j < ringlen; {
-			posneg := 1.0
-			if i != 0 {
-				posneg = -1.0
-			}
-			sum += (ring[j][0] - ring[k][0]) * (ring[j][1] + ring[k][1]) * posneg
+		posneg := 1.0
+		if i != 0 {
+			posneg = -1.0
+		}
 
-			j++
-			k = j
+		for j, k := 0, ringlen-1; j < ringlen; k, j = j, j+1 {
+			sum += (ring[j][0] - ring[k][0]) * (ring[j][1] + ring[k][1]) * posneg
 		}
 	}
 
@@ -189,10 +235,7 @@ func (cr CheapRuler) PointOnLine(line [][]float64, p []float64) PointOnLine {
 			}
 		}
 
-		dx = (p[0] - x) * cr.Kx
-		dy = (p[1] - y) * cr.Ky
-
-		sqDist := dx*dx + dy*dy
+		sqDist := cr.SquareDistance(p, []float64{x, y})
 		if sqDist < minDist {
 			minDist = sqDist
 			minX = x
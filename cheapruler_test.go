@@ -0,0 +1,20 @@
+package cheapruler
+
+import "testing"
+
+func TestArea(t *testing.T) {
+	cr, err := NewCheapruler(0, "kilometers")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Right triangle, base 4deg, height 3deg -> 6 square degrees.
+	triangle := [][][]float64{{{0, 0}, {4, 0}, {0, 3}}}
+
+	got := cr.Area(triangle)
+	want := 6 * cr.Kx * cr.Ky
+
+	if got != want {
+		t.Errorf("Area() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,81 @@
+// Package cheaprulergeojson adapts github.com/paulmach/orb/geojson features
+// to the coordinate shapes cheapruler accepts, so callers working with
+// GeoJSON don't have to hand-write the conversion glue at every call site.
+package cheaprulergeojson
+
+import "github.com/paulmach/orb"
+
+// ToCoords normalizes any orb.Geometry into the [][]float64-per-ring shape
+// cheapruler's multi-line/polygon APIs (LineDistance, Area, PointOnPolygon,
+// PointOnMultiLine, PointInPolygon, ...) already accept. Each entry of the
+// result is one "ring": a Point becomes a ring of one coordinate, a
+// LineString or Ring becomes a ring of its points, a Polygon becomes one
+// ring per linear ring (outer ring first, holes after), and the Multi*
+// geometries become one ring per member. Bound is expanded to its
+// rectangular ring, and Collection is flattened recursively.
+func ToCoords(g orb.Geometry) [][][]float64 {
+	switch geom := g.(type) {
+
+	case orb.Point:
+		return [][][]float64{{pointCoord(geom)}}
+
+	case orb.MultiPoint:
+		ring := make([][]float64, len(geom))
+		for i, p := range geom {
+			ring[i] = pointCoord(p)
+		}
+		return [][][]float64{ring}
+
+	case orb.LineString:
+		return [][][]float64{lineStringCoords(geom)}
+
+	case orb.Ring:
+		return [][][]float64{lineStringCoords(orb.LineString(geom))}
+
+	case orb.MultiLineString:
+		rings := make([][][]float64, len(geom))
+		for i, ls := range geom {
+			rings[i] = lineStringCoords(ls)
+		}
+		return rings
+
+	case orb.Polygon:
+		rings := make([][][]float64, len(geom))
+		for i, r := range geom {
+			rings[i] = lineStringCoords(orb.LineString(r))
+		}
+		return rings
+
+	case orb.MultiPolygon:
+		var rings [][][]float64
+		for _, poly := range geom {
+			rings = append(rings, ToCoords(poly)...)
+		}
+		return rings
+
+	case orb.Bound:
+		return [][][]float64{lineStringCoords(orb.LineString(geom.ToRing()))}
+
+	case orb.Collection:
+		var rings [][][]float64
+		for _, sub := range geom {
+			rings = append(rings, ToCoords(sub)...)
+		}
+		return rings
+
+	default:
+		return nil
+	}
+}
+
+func pointCoord(p orb.Point) []float64 {
+	return []float64{p[0], p[1]}
+}
+
+func lineStringCoords(ls orb.LineString) [][]float64 {
+	coords := make([][]float64, len(ls))
+	for i, p := range ls {
+		coords[i] = pointCoord(p)
+	}
+	return coords
+}
@@ -0,0 +1,85 @@
+package cheaprulergeojson
+
+import (
+	cheapruler "github.com/JamesLMilner/cheap-ruler-go"
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/geojson"
+)
+
+// DistanceFeature returns the total line distance, in the ruler's units, of
+// a LineString feature's geometry. For a MultiLineString it returns the sum
+// of every member line's distance. Returns 0 for any other geometry type.
+func DistanceFeature(cr cheapruler.CheapRuler, f *geojson.Feature) float64 {
+	switch f.Geometry.GeoJSONType() {
+	case "LineString", "MultiLineString":
+	default:
+		return 0
+	}
+
+	total := 0.0
+	for _, line := range ToCoords(f.Geometry) {
+		total += cr.LineDistance(line)
+	}
+	return total
+}
+
+// AreaFeature returns the area, in the ruler's units, of a Polygon (or
+// MultiPolygon) feature's geometry. Returns 0 for any other geometry type.
+//
+// MultiPolygon members are summed individually rather than handed to
+// cr.Area as one flat ring list: cr.Area treats ring index 0 as the outer
+// ring and every later ring as a hole, so flattening multiple polygons'
+// rings together would subtract the second and later polygons' outer
+// rings instead of adding them.
+func AreaFeature(cr cheapruler.CheapRuler, f *geojson.Feature) float64 {
+	switch f.Geometry.GeoJSONType() {
+	case "Polygon", "MultiPolygon":
+	default:
+		return 0
+	}
+
+	if mp, ok := f.Geometry.(orb.MultiPolygon); ok {
+		total := 0.0
+		for _, poly := range mp {
+			total += cr.Area(ToCoords(poly))
+		}
+		return total
+	}
+
+	return cr.Area(ToCoords(f.Geometry))
+}
+
+// LengthFeatureCollection returns the total line distance, in the ruler's
+// units, of every LineString/MultiLineString feature in fc. Other geometry
+// types are ignored.
+func LengthFeatureCollection(cr cheapruler.CheapRuler, fc *geojson.FeatureCollection) float64 {
+	total := 0.0
+
+	for _, f := range fc.Features {
+		switch f.Geometry.GeoJSONType() {
+		case "LineString", "MultiLineString":
+			for _, line := range ToCoords(f.Geometry) {
+				total += cr.LineDistance(line)
+			}
+		}
+	}
+
+	return total
+}
+
+// PointOnLineFeature returns the closest point to p on a LineString (or
+// MultiLineString, checking every line) feature's geometry.
+func PointOnLineFeature(cr cheapruler.CheapRuler, f *geojson.Feature, p []float64) cheapruler.PointOnLine {
+	coords := ToCoords(f.Geometry)
+
+	if f.Geometry.GeoJSONType() == "MultiLineString" {
+		pol, _ := cr.PointOnMultiLine(coords, p)
+		return pol
+	}
+
+	if len(coords) == 0 {
+		return cheapruler.PointOnLine{}
+	}
+
+	return cr.PointOnLine(coords[0], p)
+}
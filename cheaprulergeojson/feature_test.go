@@ -0,0 +1,100 @@
+package cheaprulergeojson
+
+import (
+	"math"
+	"testing"
+
+	cheapruler "github.com/JamesLMilner/cheap-ruler-go"
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/geojson"
+)
+
+func TestAreaFeature(t *testing.T) {
+	cr, err := cheapruler.NewCheapruler(0, "kilometers")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Right triangle, base 4deg, height 3deg -> 6 square degrees.
+	poly := orb.Polygon{{{0, 0}, {4, 0}, {0, 3}}}
+	f := geojson.NewFeature(poly)
+
+	got := AreaFeature(cr, f)
+	want := 6 * cr.Kx * cr.Ky
+
+	if math.Abs(got-want) > 1e-6 {
+		t.Errorf("AreaFeature(Polygon) = %v, want %v", got, want)
+	}
+}
+
+func TestAreaFeatureMultiPolygon(t *testing.T) {
+	cr, err := cheapruler.NewCheapruler(0, "kilometers")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	polyA := orb.Polygon{{{0, 0}, {4, 0}, {0, 3}}}
+	polyB := orb.Polygon{{{10, 10}, {14, 10}, {10, 13}}}
+	mp := orb.MultiPolygon{polyA, polyB}
+	f := geojson.NewFeature(mp)
+
+	got := AreaFeature(cr, f)
+	want := cr.Area(ToCoords(polyA)) + cr.Area(ToCoords(polyB))
+
+	if got != want {
+		t.Errorf("AreaFeature(MultiPolygon) = %v, want sum of members %v", got, want)
+	}
+	if got == 0 {
+		t.Errorf("AreaFeature(MultiPolygon) = 0, want a non-zero area")
+	}
+}
+
+func TestDistanceFeature(t *testing.T) {
+	cr, err := cheapruler.NewCheapruler(0, "kilometers")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ls := orb.LineString{{0, 0}, {5, 0}, {5, 5}}
+	f := geojson.NewFeature(ls)
+
+	got := DistanceFeature(cr, f)
+	want := cr.Distance([]float64{0, 0}, []float64{5, 0}) + cr.Distance([]float64{5, 0}, []float64{5, 5})
+
+	if got != want {
+		t.Errorf("DistanceFeature(LineString) = %v, want total line distance %v", got, want)
+	}
+}
+
+func TestDistanceFeatureMultiLineString(t *testing.T) {
+	cr, err := cheapruler.NewCheapruler(0, "kilometers")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mls := orb.MultiLineString{
+		{{0, 0}, {5, 0}},
+		{{0, 5}, {5, 5}},
+	}
+	f := geojson.NewFeature(mls)
+
+	got := DistanceFeature(cr, f)
+	want := cr.LineDistance([][]float64{{0, 0}, {5, 0}}) + cr.LineDistance([][]float64{{0, 5}, {5, 5}})
+
+	if got != want {
+		t.Errorf("DistanceFeature(MultiLineString) = %v, want sum of member lines %v", got, want)
+	}
+}
+
+func TestDistanceFeatureIgnoresOtherGeometry(t *testing.T) {
+	cr, err := cheapruler.NewCheapruler(0, "kilometers")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	f := geojson.NewFeature(orb.Point{1, 1})
+
+	if got := DistanceFeature(cr, f); got != 0 {
+		t.Errorf("DistanceFeature(Point) = %v, want 0", got)
+	}
+}
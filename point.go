@@ -0,0 +1,262 @@
+package cheapruler
+
+import "math"
+
+// Point is a fixed-size [lon, lat] pair. Being array-backed rather than a
+// slice, it avoids the header allocation and bounds checks that []float64
+// incurs in tight loops.
+type Point [2]float64
+
+// LineString is an ordered sequence of points.
+type LineString []Point
+
+// Polygon is a sequence of rings, where each ring is a LineString. The first
+// ring is the outer ring, any subsequent rings are holes.
+type Polygon []LineString
+
+// BBox is a bounding box in [w, s, e, n] order.
+type BBox [4]float64
+
+// The closest point on the line from the given point and
+// index is the start index of the segment with the closest point.
+type PointOnLineP struct {
+	Point Point
+	Index float64
+	T     float64
+}
+
+// Given two points returns the distance in the units of the ruler.
+func (cr CheapRuler) DistanceP(a Point, b Point) float64 {
+	return math.Sqrt(cr.SquareDistanceP(a, b))
+}
+
+// Given two points returns the square of the distance in the units of the
+// ruler, avoiding the cost of the square root in DistanceP.
+func (cr CheapRuler) SquareDistanceP(a Point, b Point) float64 {
+	dx := (a[0] - b[0]) * cr.Kx
+	dy := (a[1] - b[1]) * cr.Ky
+	return dx*dx + dy*dy
+}
+
+// Returns the bearing between two points in angles.
+func (cr CheapRuler) BearingP(a Point, b Point) float64 {
+	dx := (b[0] - a[0]) * cr.Kx
+	dy := (b[1] - a[1]) * cr.Ky
+	if dx == 0.0 && dy == 0.0 {
+		return 0.0
+	}
+	bearing := math.Atan2(dx, dy) * 180 / math.Pi
+	if bearing > 180 {
+		bearing -= 360
+	}
+	return bearing
+}
+
+// Returns a new point given distance and bearing from the starting point.
+func (cr CheapRuler) DestinationP(p Point, dist float64, bearing float64) Point {
+	a := (90.0 - bearing) * math.Pi / 180.0
+	return cr.OffsetP(p, math.Cos(a)*dist, math.Sin(a)*dist)
+}
+
+// Returns a new point given easting and northing offsets (in ruler units) from the starting point.
+func (cr CheapRuler) OffsetP(p Point, dx float64, dy float64) Point {
+	return Point{p[0] + dx/cr.Kx, p[1] + dy/cr.Ky}
+}
+
+// Given a line (a sequence of points), returns the total line distance.
+func (cr CheapRuler) LineDistanceP(points LineString) float64 {
+	total := 0.0
+	for i := 0; i < len(points)-1; i++ {
+		total += cr.DistanceP(points[i], points[i+1])
+	}
+	return total
+}
+
+// Given a polygon (a slice of rings, where each ring is a slice of points), returns the area.
+func (cr CheapRuler) AreaP(polygon Polygon) float64 {
+	sum := 0.0
+
+	for i := 0; i < len(polygon); i++ {
+		ring := polygon[i]
+		ringlen := len(ring)
+
+		posneg := 1.0
+		if i != 0 {
+			posneg = -1.0
+		}
+
+		for j, k := 0, ringlen-1; j < ringlen; k, j = j, j+1 {
+			sum += (ring[j][0] - ring[k][0]) * (ring[j][1] + ring[k][1]) * posneg
+		}
+	}
+
+	return (math.Abs(sum) / 2) * cr.Kx * cr.Ky
+}
+
+// Returns the point at a specified distance along the line.
+func (cr CheapRuler) AlongP(line LineString, dist float64) Point {
+	sum := 0.0
+
+	if dist <= 0 {
+		return line[0]
+	}
+
+	for i := 0; i < len(line)-1; i++ {
+		p0 := line[i]
+		p1 := line[i+1]
+		d := cr.DistanceP(p0, p1)
+		sum += d
+		if sum > dist {
+			return interpolateP(p0, p1, (dist-(sum-d))/d)
+		}
+	}
+
+	return line[len(line)-1]
+}
+
+// Returns an struct where point is closest point on the line from the given point,
+// and index is the start index of the segment with the closest point.
+func (cr CheapRuler) PointOnLineP(line LineString, p Point) PointOnLineP {
+	minDist := math.Inf(1)
+	var minX float64
+	var minY float64
+	var minI float64
+	var minT float64
+	var t float64
+
+	for i := 0; i < len(line)-1; i++ {
+
+		x := line[i][0]
+		y := line[i][1]
+		dx := (line[i+1][0] - x) * cr.Kx
+		dy := (line[i+1][1] - y) * cr.Ky
+
+		if dx != 0 || dy != 0 {
+
+			t = ((p[0]-x)*cr.Kx*dx + (p[1]-y)*cr.Ky*dy) / (dx*dx + dy*dy)
+
+			if t > 1 {
+				x = line[i+1][0]
+				y = line[i+1][1]
+
+			} else if t > 0 {
+				x += (dx / cr.Kx) * t
+				y += (dy / cr.Ky) * t
+			}
+		}
+
+		sqDist := cr.SquareDistanceP(p, Point{x, y})
+		if sqDist < minDist {
+			minDist = sqDist
+			minX = x
+			minY = y
+			minI = float64(i)
+			minT = t
+		}
+	}
+
+	return PointOnLineP{
+		Point{minX, minY},
+		minI,
+		minT,
+	}
+}
+
+// Returns a part of the given line between the start and the stop points (or their closest points on the line).
+func (cr CheapRuler) LineSliceP(start Point, stop Point, line LineString) LineString {
+	p1 := cr.PointOnLineP(line, start)
+	p2 := cr.PointOnLineP(line, stop)
+
+	if p1.Index > p2.Index || (p1.Index == p2.Index && p1.T > p2.T) {
+		p1, p2 = p2, p1
+	}
+
+	sl := LineString{p1.Point}
+
+	l := p1.Index + 1
+	r := p2.Index
+
+	if line[int(l)] != sl[0] && l <= r {
+		sl = append(sl, line[int(l)])
+	}
+
+	for i := l + 1; i <= r; i++ {
+		sl = append(sl, line[int(i)])
+	}
+
+	if line[int(r)] != p2.Point {
+		sl = append(sl, p2.Point)
+	}
+
+	return sl
+}
+
+// Returns a part of the given line between the start and the stop points indicated by distance along the line.
+func (cr CheapRuler) LineSliceAlongP(start float64, stop float64, line LineString) LineString {
+	sum := 0.0
+	var sl LineString
+
+	for i := 0; i < len(line)-1; i++ {
+		p0 := line[i]
+		p1 := line[i+1]
+		d := cr.DistanceP(p0, p1)
+
+		sum += d
+
+		if sum > start && len(sl) == 0 {
+			sl = append(sl, interpolateP(p0, p1, (start-(sum-d))/d))
+		}
+
+		if sum >= stop {
+			sl = append(sl, interpolateP(p0, p1, (stop-(sum-d))/d))
+			return sl
+		}
+
+		if sum > start {
+			sl = append(sl, p1)
+		}
+	}
+
+	return sl
+}
+
+// Given a point, returns a bounding box created from the given point buffered by a given distance.
+func (cr CheapRuler) BufferPointP(p Point, buffer float64) BBox {
+	v := buffer / cr.Ky
+	h := buffer / cr.Kx
+	return BBox{
+		p[0] - h,
+		p[1] - v,
+		p[0] + h,
+		p[1] + v,
+	}
+}
+
+// Given a bounding box, returns the box buffered by a given distance.
+func (cr CheapRuler) BufferBBoxP(bbox BBox, buffer float64) BBox {
+	v := buffer / cr.Ky
+	h := buffer / cr.Kx
+	return BBox{
+		bbox[0] - h,
+		bbox[1] - v,
+		bbox[2] + h,
+		bbox[3] + v,
+	}
+}
+
+// Returns true if the given point is inside in the given bounding box, otherwise false.
+func (cr CheapRuler) InsideBBoxP(p Point, bbox BBox) bool {
+	return p[0] >= bbox[0] &&
+		p[0] <= bbox[2] &&
+		p[1] >= bbox[1] &&
+		p[1] <= bbox[3]
+}
+
+func interpolateP(a Point, b Point, t float64) Point {
+	dx := b[0] - a[0]
+	dy := b[1] - a[1]
+	return Point{
+		a[0] + dx*t,
+		a[1] + dy*t,
+	}
+}
@@ -0,0 +1,20 @@
+package cheapruler
+
+import "testing"
+
+func TestAreaP(t *testing.T) {
+	cr, err := NewCheapruler(0, "kilometers")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Right triangle, base 4deg, height 3deg -> 6 square degrees.
+	triangle := Polygon{{{0, 0}, {4, 0}, {0, 3}}}
+
+	got := cr.AreaP(triangle)
+	want := 6 * cr.Kx * cr.Ky
+
+	if got != want {
+		t.Errorf("AreaP() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,89 @@
+package cheapruler
+
+// Given a set of lines, returns the point on the closest line to the given
+// point, along with the index of the line it belongs to.
+func (cr CheapRuler) PointOnMultiLine(lines [][][]float64, p []float64) (PointOnLine, int) {
+	best := PointOnLine{}
+	bestLine := -1
+	minDist := 0.0
+
+	for i, line := range lines {
+		if len(line) == 0 {
+			continue
+		}
+
+		candidate := cr.PointOnLine(line, p)
+		d := cr.SquareDistance(p, candidate.Point)
+
+		if bestLine == -1 || d < minDist {
+			best = candidate
+			bestLine = i
+			minDist = d
+		}
+	}
+
+	return best, bestLine
+}
+
+// Given a polygon (a slice of rings), returns the point on the closest ring
+// to the given point.
+func (cr CheapRuler) PointOnPolygon(poly [][][]float64, p []float64) PointOnLine {
+	best := PointOnLine{}
+	found := false
+	minDist := 0.0
+
+	for _, ring := range poly {
+		if len(ring) == 0 {
+			continue
+		}
+
+		candidate := cr.PointOnLine(ring, p)
+		d := cr.SquareDistance(p, candidate.Point)
+
+		if !found || d < minDist {
+			best = candidate
+			minDist = d
+			found = true
+		}
+	}
+
+	return best
+}
+
+// Given a point and a polygon (a slice of rings, where the first ring is the
+// outer ring and any subsequent rings are holes), returns true if the point
+// is inside the polygon using the even-odd ray-casting rule: the point is
+// inside if it falls within an odd number of rings, so a hole subtracts from
+// the outer ring and an island nested inside that hole adds back, and so on
+// for arbitrarily nested rings.
+func (cr CheapRuler) PointInPolygon(p []float64, poly [][][]float64) bool {
+	inside := false
+
+	for _, ring := range poly {
+		if pointInRing(p, ring) {
+			inside = !inside
+		}
+	}
+
+	return inside
+}
+
+// pointInRing implements the standard even-odd ray-casting algorithm for a
+// single ring.
+func pointInRing(p []float64, ring [][]float64) bool {
+	inside := false
+
+	for i, j := 0, len(ring)-1; i < len(ring); j, i = i, i+1 {
+		xi, yi := ring[i][0], ring[i][1]
+		xj, yj := ring[j][0], ring[j][1]
+
+		intersects := (yi > p[1]) != (yj > p[1]) &&
+			p[0] < (xj-xi)*(p[1]-yi)/(yj-yi)+xi
+
+		if intersects {
+			inside = !inside
+		}
+	}
+
+	return inside
+}
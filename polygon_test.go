@@ -0,0 +1,66 @@
+package cheapruler
+
+import "testing"
+
+func TestPointInPolygon(t *testing.T) {
+	cr, err := NewCheapruler(0, "kilometers")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	outer := [][]float64{{0, 0}, {20, 0}, {20, 20}, {0, 20}, {0, 0}}
+	hole := [][]float64{{4, 4}, {16, 4}, {16, 16}, {4, 16}, {4, 4}}
+	island := [][]float64{{8, 8}, {12, 8}, {12, 12}, {8, 12}, {8, 8}}
+	poly := [][][]float64{outer, hole, island}
+
+	cases := []struct {
+		name string
+		p    []float64
+		want bool
+	}{
+		{"outside", []float64{30, 30}, false},
+		{"in outer only", []float64{1, 1}, true},
+		{"in hole", []float64{5, 5}, false},
+		{"on nested island", []float64{10, 10}, true},
+	}
+
+	for _, c := range cases {
+		if got := cr.PointInPolygon(c.p, poly); got != c.want {
+			t.Errorf("%s: PointInPolygon(%v) = %v, want %v", c.name, c.p, got, c.want)
+		}
+	}
+}
+
+func TestPointOnMultiLine(t *testing.T) {
+	cr, err := NewCheapruler(0, "kilometers")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lines := [][][]float64{
+		{{0, 0}, {10, 0}},
+		{{0, 5}, {10, 5}},
+	}
+
+	pol, idx := cr.PointOnMultiLine(lines, []float64{3, 4.9})
+	if idx != 1 {
+		t.Errorf("PointOnMultiLine() line index = %v, want 1", idx)
+	}
+	if pol.Point[1] != 5 {
+		t.Errorf("PointOnMultiLine() point = %v, want y=5", pol.Point)
+	}
+}
+
+func TestPointOnPolygon(t *testing.T) {
+	cr, err := NewCheapruler(0, "kilometers")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	poly := [][][]float64{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}}
+
+	pol := cr.PointOnPolygon(poly, []float64{-1, 5})
+	if pol.Point[0] != 0 {
+		t.Errorf("PointOnPolygon() point = %v, want x=0", pol.Point)
+	}
+}
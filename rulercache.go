@@ -0,0 +1,64 @@
+package cheapruler
+
+import (
+	"math"
+	"sync"
+)
+
+// RulerCache lazily builds and memoizes a CheapRuler per latitude band,
+// matching how tile-reduce style pipelines fan work out across many tiles:
+// each worker needs a ruler for its tile's latitude, but constructing
+// thousands of rulers with fresh math.Cos/Pow calls is wasteful when nearby
+// tiles can safely share one. Cached rulers are immutable after
+// construction, so they're safe to read concurrently once returned by Get.
+type RulerCache struct {
+	unit    Unit
+	latStep float64
+	cache   sync.Map // map[float64]CheapRuler, keyed by snapped latitude band
+}
+
+// Default band width used when NewRulerCache is given a non-positive
+// latStepDegrees; a zero step would otherwise make every band snap to NaN.
+const defaultLatStepDegrees = 1.0
+
+// NewRulerCache creates a RulerCache that builds rulers in the given units,
+// snapping requested latitudes to bands latStepDegrees wide. An unrecognised
+// units string falls back to kilometers, and a non-positive latStepDegrees
+// falls back to defaultLatStepDegrees.
+func NewRulerCache(units string, latStepDegrees float64) *RulerCache {
+	u, err := ParseUnit(units)
+	if err != nil {
+		u = Kilometers
+	}
+
+	if latStepDegrees <= 0 {
+		latStepDegrees = defaultLatStepDegrees
+	}
+
+	return &RulerCache{
+		unit:    u,
+		latStep: latStepDegrees,
+	}
+}
+
+// Get returns the CheapRuler for the latitude band nearest to lat, building
+// and memoizing it on first use. Safe for concurrent use.
+func (c *RulerCache) Get(lat float64) CheapRuler {
+	band := math.Round(lat/c.latStep) * c.latStep
+
+	if v, ok := c.cache.Load(band); ok {
+		return v.(CheapRuler)
+	}
+
+	cr := NewCheapRulerUnit(band, c.unit)
+	actual, _ := c.cache.LoadOrStore(band, cr)
+	return actual.(CheapRuler)
+}
+
+// GetForTile returns the CheapRuler for the latitude band at the center of
+// the given tile coordinates, as used by tile-reduce style pipelines.
+func (c *RulerCache) GetForTile(y uint32, z uint32) CheapRuler {
+	n := math.Pi * (1 - 2*(float64(y)+0.5)/math.Pow(2, float64(z)))
+	lat := math.Atan(0.5*(math.Exp(n)-math.Exp(-n))) * 180 / math.Pi
+	return c.Get(lat)
+}
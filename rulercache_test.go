@@ -0,0 +1,55 @@
+package cheapruler
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestRulerCacheGet(t *testing.T) {
+	c := NewRulerCache("kilometers", 1.0)
+
+	a := c.Get(37.2)
+	b := c.Get(37.4)
+
+	if a.Kx != b.Kx || a.Ky != b.Ky {
+		t.Errorf("Get() snapped to different bands for nearby latitudes: %+v vs %+v", a, b)
+	}
+
+	far := c.Get(60.0)
+	if far.Kx == a.Kx {
+		t.Errorf("Get() returned the same ruler for latitudes in different bands")
+	}
+}
+
+func TestRulerCacheNonPositiveLatStep(t *testing.T) {
+	c := NewRulerCache("kilometers", 0)
+
+	cr := c.Get(12.3)
+	if cr.Kx == 0 || cr.Ky == 0 {
+		t.Errorf("Get() with non-positive latStepDegrees produced a degenerate ruler: %+v", cr)
+	}
+}
+
+func TestRulerCacheConcurrentGet(t *testing.T) {
+	c := NewRulerCache("kilometers", 1.0)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			cr := c.Get(float64(i % 10))
+			cr.Distance([]float64{0, 0}, []float64{1, 1})
+		}(i)
+	}
+	wg.Wait()
+}
+
+func TestRulerCacheGetForTile(t *testing.T) {
+	c := NewRulerCache("kilometers", 1.0)
+
+	cr := c.GetForTile(1, 2)
+	if cr.Kx == 0 || cr.Ky == 0 {
+		t.Errorf("GetForTile() produced a degenerate ruler: %+v", cr)
+	}
+}
@@ -0,0 +1,62 @@
+package cheapruler
+
+import "errors"
+
+// Unit is a typed enum of the distance units CheapRuler supports, for callers
+// who want to avoid passing raw strings around.
+type Unit int
+
+const (
+	Kilometers Unit = iota
+	Miles
+	NauticalMiles
+	Meters
+	Yards
+	Feet
+	Inches
+)
+
+// String returns the lowercase unit name used internally and by
+// NewCheapruler, e.g. "kilometers".
+func (u Unit) String() string {
+	switch u {
+	case Kilometers:
+		return "kilometers"
+	case Miles:
+		return "miles"
+	case NauticalMiles:
+		return "nauticalmiles"
+	case Meters:
+		return "meters"
+	case Yards:
+		return "yards"
+	case Feet:
+		return "feet"
+	case Inches:
+		return "inches"
+	default:
+		return "unknown"
+	}
+}
+
+// ParseUnit converts a unit string (as accepted by NewCheapruler) into a Unit.
+func ParseUnit(units string) (Unit, error) {
+	switch units {
+	case "kilometers":
+		return Kilometers, nil
+	case "miles":
+		return Miles, nil
+	case "nauticalmiles":
+		return NauticalMiles, nil
+	case "meters", "metres":
+		return Meters, nil
+	case "yards":
+		return Yards, nil
+	case "feet":
+		return Feet, nil
+	case "inches":
+		return Inches, nil
+	default:
+		return 0, errors.New(units + " is not a valid unit")
+	}
+}